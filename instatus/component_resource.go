@@ -16,13 +16,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	is "github.com/paydaycay/instatus-client-go"
+
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/cache"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/client"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/diag"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &componentResource{}
-	_ resource.ResourceWithConfigure   = &componentResource{}
-	_ resource.ResourceWithImportState = &componentResource{}
+	_ resource.Resource                 = &componentResource{}
+	_ resource.ResourceWithConfigure    = &componentResource{}
+	_ resource.ResourceWithImportState  = &componentResource{}
+	_ resource.ResourceWithUpgradeState = &componentResource{}
 )
 
 // Configure adds the provider configured client to the resource.
@@ -31,7 +36,9 @@ func (r *componentResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*is.Client)
+	data := req.ProviderData.(*providerData)
+	r.client = data.Client
+	r.cache = data.Cache
 }
 
 // NewComponentResource is a helper function to simplify the provider implementation.
@@ -41,7 +48,8 @@ func NewComponentResource() resource.Resource {
 
 // componentResource is the resource implementation.
 type componentResource struct {
-	client *is.Client
+	client *client.RetryingClient
+	cache  *cache.PageComponents
 }
 
 // componentResourceModel maps the resource schema data.
@@ -70,6 +78,89 @@ func (r *componentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	componentStatuses := []string{"OPERATIONAL", "UNDERMAINTENANCE", "DEGRADEDPERFORMANCE", "PARTIALOUTAGE", "MAJOROUTAGE"}
 
 	resp.Schema = schema.Schema{
+		Version:     1,
+		Description: "Manages a component.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String Identifier of the component.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unique_email": schema.StringAttribute{
+				Description: "Unique email generated by Instatus for the component.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the component.",
+				Computed:    true,
+			},
+			"page_id": schema.StringAttribute{
+				Description: "String Identifier of the page of the component.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the component.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the component.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: fmt.Sprintf("Status of the component. One of: (%s).", strings.Join(componentStatuses, ", ")),
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(componentStatuses...)},
+			},
+			"order": schema.Int64Attribute{
+				Description: "Order in the page of the component.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "String Identifier of the parent group of the component.",
+				Computed:    true,
+			},
+			"show_uptime": schema.BoolAttribute{
+				Description: "Whether show uptime is enabled in the component.",
+				Optional:    true,
+			},
+			"grouped": schema.BoolAttribute{
+				Description: "Whether the component is in a group (Require group set to desired name when true).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"group": schema.StringAttribute{
+				Description: "Name of the group for the component (Require grouped set to true).",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// UpgradeState returns the schema upgraders for each prior schema version of
+// this resource, keyed by the version they upgrade from. This lets the
+// schema evolve (e.g. splitting group/grouped into a nested block, changing
+// order's type, turning status into an enum) without forcing a taint and
+// recreate of every existing component.
+func (r *componentResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   componentResourceSchemaV0(),
+			StateUpgrader: upgradeComponentResourceStateV0toV1,
+		},
+	}
+}
+
+// componentResourceSchemaV0 is the schema as it existed prior to the
+// introduction of SchemaVersion (commit 609a672), copied verbatim for use
+// by UpgradeState.
+func componentResourceSchemaV0() *schema.Schema {
+	componentStatuses := []string{"OPERATIONAL", "UNDERMAINTENANCE", "DEGRADEDPERFORMANCE", "PARTIALOUTAGE", "MAJOROUTAGE"}
+
+	return &schema.Schema{
 		Description: "Manages a component.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -131,6 +222,22 @@ func (r *componentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// upgradeComponentResourceStateV0toV1 migrates raw state from schema version
+// 0 to version 1. The attributes are unchanged between the two versions, so
+// this is a straight passthrough; it exists as the seam future breaking
+// changes (e.g. a grouping block, an enum status type) will hang their
+// conversion logic off of.
+func upgradeComponentResourceStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorState componentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, priorState)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *componentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -154,13 +261,12 @@ func (r *componentResource) Create(ctx context.Context, req resource.CreateReque
 	// Create new component
 	component, err := r.client.CreateComponent(plan.PageID.ValueString(), &item)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating component",
-			"Could not create component, unexpected error: "+err.Error(),
-		)
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("page_id"), "Error creating component", err)
 		return
 	}
 
+	r.cache.Invalidate(plan.PageID.ValueString())
+
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringPointerValue(component.ID)
 	plan.UniqueEmail = types.StringPointerValue(component.UniqueEmail)
@@ -187,13 +293,21 @@ func (r *componentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Get refreshed component value from Instatus
-	component, err := r.client.GetComponent(state.PageID.ValueString(), state.ID.ValueString())
+	// Get refreshed component value from Instatus, via the page-scoped cache
+	// so pages with many components issue one ListComponents call per plan
+	// or apply instead of one GetComponent call per component.
+	pageID := state.PageID.ValueString()
+	components, err := r.cache.Get(pageID, func() ([]*is.ComponentFull, error) {
+		return r.client.ListComponents(pageID)
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Instatus Component",
-			"Could not read Instatus component ID "+state.ID.ValueString()+": "+err.Error(),
-		)
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("id"), "Error reading Instatus component "+state.ID.ValueString(), err)
+		return
+	}
+
+	component := findComponent(components, state.ID.ValueString())
+	if component == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 	// Overwrite items with refreshed state
@@ -239,14 +353,12 @@ func (r *componentResource) Update(ctx context.Context, req resource.UpdateReque
 	// Update existing component
 	component, err := r.client.UpdateComponent(plan.PageID.ValueString(), plan.ID.ValueString(), &item)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Instatus Component",
-			"Could not update component, unexpected error: "+err.Error(),
-		)
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("id"), "Error updating Instatus component", err)
 		return
 	}
 
-	resp.Diagnostics.AddWarning("Group name : "+types.StringPointerValue(component.Group.Name).ValueString(), types.StringPointerValue(component.Group.Name).ValueString())
+	r.cache.Invalidate(plan.PageID.ValueString())
+
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringPointerValue(component.ID)
 	plan.GroupID = types.StringPointerValue(component.GroupID)
@@ -276,15 +388,25 @@ func (r *componentResource) Delete(ctx context.Context, req resource.DeleteReque
 	// Delete existing component
 	err := r.client.DeleteComponent(state.PageID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Instatus Component",
-			"Could not delete component, unexpected error: "+err.Error(),
-		)
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("id"), "Error deleting Instatus component", err)
 		return
 	}
+
+	r.cache.Invalidate(state.PageID.ValueString())
 }
 
 func (r *componentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// findComponent returns the component with the given id from components, or
+// nil if it isn't present.
+func findComponent(components []*is.ComponentFull, id string) *is.ComponentFull {
+	for _, component := range components {
+		if component.ID != nil && *component.ID == id {
+			return component
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package instatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestUpgradeComponentResourceStateV0toV1 exercises the v0 -> v1 state
+// upgrader returned from componentResource.UpgradeState, verifying that
+// state written under the prior schema survives the upgrade unchanged.
+func TestUpgradeComponentResourceStateV0toV1(t *testing.T) {
+	ctx := context.Background()
+
+	priorSchema := componentResourceSchemaV0()
+
+	var currentSchemaResp resource.SchemaResponse
+	(&componentResource{}).Schema(ctx, resource.SchemaRequest{}, &currentSchemaResp)
+	if currentSchemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics building current schema: %s", currentSchemaResp.Diagnostics)
+	}
+
+	raw := tftypes.NewValue(priorSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "c123"),
+		"unique_email": tftypes.NewValue(tftypes.String, "c123@statuspage.io"),
+		"last_updated": tftypes.NewValue(tftypes.String, "Monday, 01-Jan-26 00:00:00 UTC"),
+		"page_id":      tftypes.NewValue(tftypes.String, "p123"),
+		"name":         tftypes.NewValue(tftypes.String, "API"),
+		"description":  tftypes.NewValue(tftypes.String, "Public API"),
+		"status":       tftypes.NewValue(tftypes.String, "OPERATIONAL"),
+		"order":        tftypes.NewValue(tftypes.Number, 1),
+		"group_id":     tftypes.NewValue(tftypes.String, "g123"),
+		"show_uptime":  tftypes.NewValue(tftypes.Bool, true),
+		"grouped":      tftypes.NewValue(tftypes.Bool, false),
+		"group":        tftypes.NewValue(tftypes.String, nil),
+	})
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Raw:    raw,
+			Schema: *priorSchema,
+		},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: currentSchemaResp.Schema,
+		},
+	}
+
+	upgradeComponentResourceStateV0toV1(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics upgrading state: %s", resp.Diagnostics)
+	}
+
+	var got componentResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading upgraded state: %s", diags)
+	}
+
+	if got.ID.ValueString() != "c123" {
+		t.Errorf("ID = %q, want %q", got.ID.ValueString(), "c123")
+	}
+	if got.PageID.ValueString() != "p123" {
+		t.Errorf("PageID = %q, want %q", got.PageID.ValueString(), "p123")
+	}
+	if got.Name.ValueString() != "API" {
+		t.Errorf("Name = %q, want %q", got.Name.ValueString(), "API")
+	}
+	if got.Status.ValueString() != "OPERATIONAL" {
+		t.Errorf("Status = %q, want %q", got.Status.ValueString(), "OPERATIONAL")
+	}
+	if got.Order.ValueInt64() != 1 {
+		t.Errorf("Order = %d, want 1", got.Order.ValueInt64())
+	}
+	if got.Grouped.ValueBool() {
+		t.Errorf("Grouped = true, want false")
+	}
+}
@@ -0,0 +1,200 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	is "github.com/paydaycay/instatus-client-go"
+
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/cache"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/client"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/diag"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &componentTriggerResource{}
+	_ resource.ResourceWithConfigure = &componentTriggerResource{}
+)
+
+// NewComponentTriggerResource is a helper function to simplify the provider implementation.
+func NewComponentTriggerResource() resource.Resource {
+	return &componentTriggerResource{}
+}
+
+// componentTriggerResource is a terraform_data-style resource: it holds no
+// desired state of its own, and instead re-pushes the current status of the
+// referenced component whenever triggers changes, without requiring the
+// component resource itself to be re-planned.
+type componentTriggerResource struct {
+	client *client.RetryingClient
+	cache  *cache.PageComponents
+}
+
+// componentTriggerResourceModel maps the resource schema data.
+type componentTriggerResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ComponentID types.String `tfsdk:"component_id"`
+	PageID      types.String `tfsdk:"page_id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *componentTriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.Client
+	r.cache = data.Cache
+}
+
+// Metadata returns the resource type name.
+func (r *componentTriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component_trigger"
+}
+
+// Schema defines the schema for the resource.
+func (r *componentTriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a status re-push against a component whenever `triggers` changes, without requiring the component itself to be re-planned. Mirrors the ergonomics of Terraform's built-in `terraform_data` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String Identifier of this trigger.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"page_id": schema.StringAttribute{
+				Description: "String Identifier of the page of the referenced component.",
+				Required:    true,
+			},
+			"component_id": schema.StringAttribute{
+				Description: "String Identifier of the component to re-push on trigger changes.",
+				Required:    true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, cause the referenced component's status to be re-pushed. Commonly wired to an upstream health-check module's output.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last trigger-induced re-push.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create performs the initial status re-push and sets the Terraform state.
+func (r *componentTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan componentTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rePush(plan.PageID.ValueString(), plan.ComponentID.ValueString()); err != nil {
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("component_id"), "Error re-pushing component status", err)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s-%d", plan.ComponentID.ValueString(), time.Now().UnixNano()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read is a no-op: this resource tracks no upstream state of its own beyond
+// what Terraform already holds, so there is nothing to refresh.
+func (r *componentTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state componentTriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update re-pushes the component's status whenever triggers has changed.
+func (r *componentTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan componentTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state componentTriggerResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	if !plan.Triggers.Equal(state.Triggers) {
+		if err := r.rePush(plan.PageID.ValueString(), plan.ComponentID.ValueString()); err != nil {
+			diag.HandleAPIError(&resp.Diagnostics, path.Root("component_id"), "Error re-pushing component status", err)
+			return
+		}
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	} else {
+		plan.LastUpdated = state.LastUpdated
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from Terraform state. No upstream call is made
+// since deleting a trigger has no effect on the referenced component.
+func (r *componentTriggerResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// rePush re-reads the referenced component and writes it back unchanged,
+// forcing Instatus to re-push it to subscribers. UpdateComponent replaces
+// the whole component (see the Create/Update field sets in
+// component_resource.go), so every field must be resent here, not just
+// Status, or a trigger-induced re-push would blank out the component's
+// name, description, order, and grouping as a side effect.
+func (r *componentTriggerResource) rePush(pageID, componentID string) error {
+	component, err := r.client.GetComponent(pageID, componentID)
+	if err != nil {
+		return err
+	}
+
+	grouped := component.Group.Name != nil
+
+	_, err = r.client.UpdateComponent(pageID, componentID, &is.Component{
+		Name:        component.Name,
+		Description: component.Description,
+		Status:      component.Status,
+		Order:       component.Order,
+		ShowUptime:  component.ShowUptime,
+		Grouped:     &grouped,
+		Group:       component.Group.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.cache.Invalidate(pageID)
+	return nil
+}
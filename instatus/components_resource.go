@@ -0,0 +1,347 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	is "github.com/paydaycay/instatus-client-go"
+
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/cache"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/client"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/diag"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &componentsResource{}
+	_ resource.ResourceWithConfigure = &componentsResource{}
+)
+
+// maxConcurrentWrites bounds how many CreateComponent/UpdateComponent/
+// DeleteComponent calls componentsResource issues at once while
+// reconciling its component list. paydaycay/instatus-client-go has no
+// endpoint to batch component writes into a single request, so this
+// bounded worker pool (see internal/client.RunBounded) is the fallback:
+// a page of 50+ components still reconciles as a handful of requests in
+// flight at once, not one at a time.
+const maxConcurrentWrites = 5
+
+// NewComponentsResource is a helper function to simplify the provider implementation.
+func NewComponentsResource() resource.Resource {
+	return &componentsResource{}
+}
+
+// componentsResource is a plural counterpart to componentResource: it
+// manages every component on a page as a single unit, reconciling the
+// configured list against Instatus (creating, updating, and deleting
+// components as needed) instead of requiring one instatus_component
+// resource per component. Reads take a single ListComponents call for the
+// page; writes are still one request per component, run concurrently.
+type componentsResource struct {
+	client *client.RetryingClient
+	cache  *cache.PageComponents
+}
+
+// componentsResourceModel maps the resource schema data.
+type componentsResourceModel struct {
+	ID         types.String         `tfsdk:"id"`
+	PageID     types.String         `tfsdk:"page_id"`
+	Components []componentItemModel `tfsdk:"components"`
+}
+
+// componentItemModel maps a single entry in componentsResourceModel.Components.
+type componentItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+	Order       types.Int64  `tfsdk:"order"`
+	GroupID     types.String `tfsdk:"group_id"`
+	ShowUptime  types.Bool   `tfsdk:"show_uptime"`
+	Grouped     types.Bool   `tfsdk:"grouped"`
+	Group       types.String `tfsdk:"group"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *componentsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.Client
+	r.cache = data.Cache
+}
+
+// Metadata returns the resource type name.
+func (r *componentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_components"
+}
+
+// Schema defines the schema for the resource.
+func (r *componentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	componentStatuses := []string{"OPERATIONAL", "UNDERMAINTENANCE", "DEGRADEDPERFORMANCE", "PARTIALOUTAGE", "MAJOROUTAGE"}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages every component on a page as a single unit, instead of one instatus_component resource per component. Every create or update reconciles the configured components list against Instatus - entries without a matching name are created, missing entries are deleted, the rest are updated - with one write request per component, run through a bounded pool of concurrent requests. Reads use a single listing of the page's components.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String Identifier of this resource, equal to page_id.",
+				Computed:    true,
+			},
+			"page_id": schema.StringAttribute{
+				Description: "String Identifier of the page whose components should be managed.",
+				Required:    true,
+			},
+			"components": schema.ListNestedAttribute{
+				Description: "Desired components on the page, correlated across applies by name.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "String Identifier of the component.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the component. Used to correlate this entry across applies; renaming an entry is seen as deleting one component and creating another.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the component.",
+							Optional:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: fmt.Sprintf("Status of the component. One of: (%s).", strings.Join(componentStatuses, ", ")),
+							Optional:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(componentStatuses...)},
+						},
+						"order": schema.Int64Attribute{
+							Description: "Order in the page of the component.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"group_id": schema.StringAttribute{
+							Description: "String Identifier of the parent group of the component.",
+							Computed:    true,
+						},
+						"show_uptime": schema.BoolAttribute{
+							Description: "Whether show uptime is enabled in the component.",
+							Optional:    true,
+						},
+						"grouped": schema.BoolAttribute{
+							Description: "Whether the component is in a group (Require group set to desired name when true).",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"group": schema.StringAttribute{
+							Description: "Name of the group for the component (Require grouped set to true).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create reconciles the configured components against an empty page (every
+// entry is a create) and sets the initial Terraform state.
+func (r *componentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan componentsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageID := plan.PageID.ValueString()
+	reconciled, err := r.reconcile(pageID, nil, plan.Components)
+	if err != nil {
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("components"), "Error creating Instatus components", err)
+		return
+	}
+	r.cache.Invalidate(pageID)
+
+	plan.ID = plan.PageID
+	plan.Components = reconciled
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with each component's latest upstream
+// values, dropping any entry that no longer exists so Terraform plans to
+// recreate it.
+func (r *componentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state componentsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageID := state.PageID.ValueString()
+	components, err := r.cache.Get(pageID, func() ([]*is.ComponentFull, error) {
+		return r.client.ListComponents(pageID)
+	})
+	if err != nil {
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("page_id"), "Error reading Instatus components", err)
+		return
+	}
+
+	byID := make(map[string]*is.ComponentFull, len(components))
+	for _, c := range components {
+		if c.ID != nil {
+			byID[*c.ID] = c
+		}
+	}
+
+	refreshed := make([]componentItemModel, 0, len(state.Components))
+	for _, item := range state.Components {
+		component, ok := byID[item.ID.ValueString()]
+		if !ok {
+			continue
+		}
+		refreshed = append(refreshed, componentItemModelFromAPI(component))
+	}
+	state.Components = refreshed
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update reconciles the configured components against the prior state,
+// creating, updating, and deleting components as needed.
+func (r *componentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan componentsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state componentsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageID := plan.PageID.ValueString()
+	reconciled, err := r.reconcile(pageID, state.Components, plan.Components)
+	if err != nil {
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("components"), "Error updating Instatus components", err)
+		return
+	}
+	r.cache.Invalidate(pageID)
+
+	plan.ID = plan.PageID
+	plan.Components = reconciled
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes every component tracked in state from the page.
+func (r *componentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state componentsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageID := state.PageID.ValueString()
+	if _, err := r.reconcile(pageID, state.Components, nil); err != nil {
+		diag.HandleAPIError(&resp.Diagnostics, path.Root("components"), "Error deleting Instatus components", err)
+		return
+	}
+	r.cache.Invalidate(pageID)
+}
+
+// reconcile turns existing into desired, correlating entries by name, and
+// returns desired with every entry's computed attributes populated from
+// the API response. Creates and updates run concurrently through
+// maxConcurrentWrites; any entries left in existing once every desired
+// entry has been matched are deleted the same way.
+func (r *componentsResource) reconcile(pageID string, existing, desired []componentItemModel) ([]componentItemModel, error) {
+	existingByName := make(map[string]componentItemModel, len(existing))
+	for _, item := range existing {
+		existingByName[item.Name.ValueString()] = item
+	}
+
+	results := make([]componentItemModel, len(desired))
+	errs := client.RunBounded(len(desired), maxConcurrentWrites, func(i int) error {
+		item := desired[i]
+		payload := &is.Component{
+			Name:        item.Name.ValueStringPointer(),
+			Description: item.Description.ValueStringPointer(),
+			Status:      item.Status.ValueStringPointer(),
+			Order:       item.Order.ValueInt64Pointer(),
+			ShowUptime:  item.ShowUptime.ValueBoolPointer(),
+			Grouped:     item.Grouped.ValueBoolPointer(),
+			Group:       item.Group.ValueStringPointer(),
+		}
+
+		var component *is.ComponentFull
+		var err error
+		if prior, ok := existingByName[item.Name.ValueString()]; ok {
+			component, err = r.client.UpdateComponent(pageID, prior.ID.ValueString(), payload)
+		} else {
+			component, err = r.client.CreateComponent(pageID, payload)
+		}
+		if err != nil {
+			return err
+		}
+		results[i] = componentItemModelFromAPI(component)
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	toDelete := make([]componentItemModel, 0, len(existingByName))
+	for _, item := range desired {
+		delete(existingByName, item.Name.ValueString())
+	}
+	for _, item := range existingByName {
+		toDelete = append(toDelete, item)
+	}
+
+	errs = client.RunBounded(len(toDelete), maxConcurrentWrites, func(i int) error {
+		return r.client.DeleteComponent(pageID, toDelete[i].ID.ValueString())
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// componentItemModelFromAPI maps an *is.ComponentFull to a componentItemModel.
+func componentItemModelFromAPI(component *is.ComponentFull) componentItemModel {
+	return componentItemModel{
+		ID:          types.StringPointerValue(component.ID),
+		Name:        types.StringPointerValue(component.Name),
+		Description: types.StringPointerValue(component.Description),
+		Status:      types.StringPointerValue(component.Status),
+		Order:       types.Int64PointerValue(component.Order),
+		GroupID:     types.StringPointerValue(component.GroupID),
+		ShowUptime:  types.BoolPointerValue(component.ShowUptime),
+		Grouped:     types.BoolValue(component.Group.Name != nil),
+		Group:       types.StringPointerValue(component.Group.Name),
+	}
+}
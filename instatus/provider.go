@@ -0,0 +1,152 @@
+package instatus
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	is "github.com/paydaycay/instatus-client-go"
+
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/cache"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ provider.Provider = &instatusProvider{}
+
+// New is a helper function to simplify provider server and testing implementation.
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &instatusProvider{version: version}
+	}
+}
+
+// instatusProvider is the provider implementation.
+type instatusProvider struct {
+	version string
+}
+
+// instatusProviderModel maps the provider schema data.
+type instatusProviderModel struct {
+	APIKey          types.String `tfsdk:"api_key"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	MinRetryBackoff types.String `tfsdk:"min_retry_backoff"`
+	MaxRetryBackoff types.String `tfsdk:"max_retry_backoff"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+}
+
+// Metadata returns the provider type name.
+func (p *instatusProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "instatus"
+	resp.Version = p.version
+}
+
+// Schema defines the schema for the provider.
+func (p *instatusProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Interact with Instatus status pages.",
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Description: "API key used to authenticate with Instatus. May also be set via the INSTATUS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times to retry a request that fails with a rate limit or transient server error. Defaults to 4.",
+				Optional:    true,
+			},
+			"min_retry_backoff": schema.StringAttribute{
+				Description: "Backoff bound for the first retry of a failed request, doubled on each further retry up to max_retry_backoff, as a Go duration string (e.g. \"1s\"). Each wait is a random duration between zero and that bound, so it can be shorter than this value. Defaults to \"1s\".",
+				Optional:    true,
+			},
+			"max_retry_backoff": schema.StringAttribute{
+				Description: "Maximum backoff before retrying a failed request, as a Go duration string (e.g. \"30s\"). A Retry-After header returned by Instatus is honored as given and is not capped by this value. Defaults to \"30s\".",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout applied to each individual request attempt, as a Go duration string (e.g. \"30s\"). Defaults to \"30s\".",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure prepares an Instatus API client for resources to consume.
+func (p *instatusProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config instatusProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey := os.Getenv("INSTATUS_API_KEY")
+	if !config.APIKey.IsNull() {
+		apiKey = config.APIKey.ValueString()
+	}
+	if apiKey == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Instatus API Key",
+			"The provider cannot create the Instatus API client without an api_key. Set the api_key attribute or the INSTATUS_API_KEY environment variable.",
+		)
+		return
+	}
+
+	cfg := client.DefaultConfig()
+	cfg.APIKey = apiKey
+	if !config.MaxRetries.IsNull() {
+		cfg.MaxRetries = config.MaxRetries.ValueInt64()
+	}
+	if !config.MinRetryBackoff.IsNull() {
+		if d, err := time.ParseDuration(config.MinRetryBackoff.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("min_retry_backoff"), "Invalid min_retry_backoff", err.Error())
+		} else {
+			cfg.MinRetryBackoff = d
+		}
+	}
+	if !config.MaxRetryBackoff.IsNull() {
+		if d, err := time.ParseDuration(config.MaxRetryBackoff.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retry_backoff"), "Invalid max_retry_backoff", err.Error())
+		} else {
+			cfg.MaxRetryBackoff = d
+		}
+	}
+	if !config.RequestTimeout.IsNull() {
+		if d, err := time.ParseDuration(config.RequestTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", err.Error())
+		} else {
+			cfg.RequestTimeout = d
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &providerData{
+		Client: client.New(is.NewClient(apiKey), cfg),
+		Cache:  cache.NewPageComponents(),
+	}
+
+	resp.DataSourceData = data
+	resp.ResourceData = data
+}
+
+// DataSources defines the data sources implemented in the provider.
+func (p *instatusProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// Resources defines the resources implemented in the provider.
+func (p *instatusProvider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewComponentResource,
+		NewComponentTriggerResource,
+		NewComponentsResource,
+	}
+}
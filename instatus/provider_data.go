@@ -0,0 +1,15 @@
+package instatus
+
+import (
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/cache"
+	"github.com/scotaitconsulting/terraform-provider-instatus/internal/client"
+)
+
+// providerData is the value the provider passes to each resource's
+// Configure method. It bundles the retrying API client with the
+// page-scoped component cache shared across every resource for the
+// lifetime of a single plan or apply.
+type providerData struct {
+	Client *client.RetryingClient
+	Cache  *cache.PageComponents
+}
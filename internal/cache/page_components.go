@@ -0,0 +1,79 @@
+// Package cache holds the page-scoped component listing cache shared by the
+// provider's resources, so that a page with many components doesn't pay one
+// ListComponents call per resource during a single plan or apply.
+package cache
+
+import (
+	"sync"
+
+	is "github.com/paydaycay/instatus-client-go"
+)
+
+// pageEntry holds the cached listing for a single page, guarded by its own
+// mutex so a slow or retrying fetch for one page never blocks reads or
+// invalidations for any other page.
+type pageEntry struct {
+	mu         sync.Mutex
+	fetched    bool
+	components []*is.ComponentFull
+}
+
+// PageComponents caches the component listing for a page for as long as the
+// cache itself is kept alive, which should be the lifetime of a single
+// provider configuration (i.e. one plan or apply). It is safe for
+// concurrent use; locking is scoped per page, so concurrent Get calls for
+// different pages never contend with each other.
+type PageComponents struct {
+	mu    sync.Mutex // guards pages only; never held across a fetch
+	pages map[string]*pageEntry
+}
+
+// NewPageComponents returns an empty cache.
+func NewPageComponents() *PageComponents {
+	return &PageComponents{pages: make(map[string]*pageEntry)}
+}
+
+// entry returns the pageEntry for pageID, creating one if necessary.
+func (c *PageComponents) entry(pageID string) *pageEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.pages[pageID]
+	if !ok {
+		e = &pageEntry{}
+		c.pages[pageID] = e
+	}
+	return e
+}
+
+// Get returns the cached component listing for pageID, calling fetch to
+// populate the cache on a miss. Only concurrent callers for the same pageID
+// are serialized; fetch is never called while holding the cache's map lock.
+func (c *PageComponents) Get(pageID string, fetch func() ([]*is.ComponentFull, error)) ([]*is.ComponentFull, error) {
+	e := c.entry(pageID)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.fetched {
+		return e.components, nil
+	}
+
+	components, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	e.components = components
+	e.fetched = true
+	return components, nil
+}
+
+// Invalidate drops the cached listing for pageID. Call this after any
+// mutating operation (create/update/delete) against a component on that
+// page so the next Get reflects the change.
+func (c *PageComponents) Invalidate(pageID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pages, pageID)
+}
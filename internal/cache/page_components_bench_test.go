@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	is "github.com/paydaycay/instatus-client-go"
+)
+
+// fakeComponents builds n stand-in components, mirroring what a page with n
+// components on it would return from ListComponents.
+func fakeComponents(n int) []*is.ComponentFull {
+	components := make([]*is.ComponentFull, n)
+	for i := range components {
+		id := fmt.Sprintf("c%d", i)
+		components[i] = &is.ComponentFull{ID: &id}
+	}
+	return components
+}
+
+// simulatedAPILatency stands in for the network round-trip a real
+// ListComponents/GetComponent call would incur.
+const simulatedAPILatency = time.Millisecond
+
+// BenchmarkPageRead_Uncached simulates reading every component on a
+// 50-component page the pre-cache way: one API call per component read.
+func BenchmarkPageRead_Uncached(b *testing.B) {
+	const componentCount = 50
+	components := fakeComponents(componentCount)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		apiCalls := 0
+		for _, c := range components {
+			apiCalls++
+			time.Sleep(simulatedAPILatency)
+			_ = c
+		}
+		if apiCalls != componentCount {
+			b.Fatalf("expected %d API calls, got %d", componentCount, apiCalls)
+		}
+	}
+}
+
+// BenchmarkPageRead_Cached simulates the same read pattern through
+// PageComponents: only the first read of a plan/apply issues a
+// ListComponents call, every subsequent read for the same page is served
+// from cache.
+func BenchmarkPageRead_Cached(b *testing.B) {
+	const componentCount = 50
+	components := fakeComponents(componentCount)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pc := NewPageComponents()
+		apiCalls := 0
+		for range components {
+			_, err := pc.Get("page-1", func() ([]*is.ComponentFull, error) {
+				apiCalls++
+				time.Sleep(simulatedAPILatency)
+				return components, nil
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		if apiCalls != 1 {
+			b.Fatalf("expected 1 API call, got %d", apiCalls)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package client wraps paydaycay/instatus-client-go with retry and backoff
+// behavior so that a single transient failure from the Instatus API doesn't
+// fail an entire Terraform apply.
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	is "github.com/paydaycay/instatus-client-go"
+)
+
+const apiRoot = "https://api.instatus.com/v1"
+
+// Config controls the retry behavior of RetryingClient. It is populated from
+// the provider configuration block's max_retries, min_retry_backoff,
+// max_retry_backoff, and request_timeout attributes.
+type Config struct {
+	APIKey          string
+	MaxRetries      int64
+	MinRetryBackoff time.Duration
+	MaxRetryBackoff time.Duration
+	RequestTimeout  time.Duration
+}
+
+// DefaultConfig returns the retry settings used when the provider
+// configuration block leaves the corresponding attributes unset.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries:      4,
+		MinRetryBackoff: time.Second,
+		MaxRetryBackoff: 30 * time.Second,
+		RequestTimeout:  30 * time.Second,
+	}
+}
+
+// RetryingClient is an *is.Client with a retrying HTTPClient installed via
+// UseHTTPClient - the extension point the library exposes ("leaving the
+// choice of using a retry library to the user") - so every CRUD method
+// (CreateComponent, GetComponent, ...) retries rate limits and transient
+// server errors uniformly, without each call site needing to know about it.
+type RetryingClient struct {
+	*is.Client
+	transport *retryingTransport
+}
+
+// New returns client wrapped with cfg's retry behavior.
+func New(c *is.Client, cfg Config) *RetryingClient {
+	transport := &retryingTransport{
+		inner: &http.Client{Timeout: cfg.RequestTimeout},
+		cfg:   cfg,
+	}
+	c.UseHTTPClient(transport)
+
+	return &RetryingClient{Client: c, transport: transport}
+}
+
+// ListComponents lists every component on a page in a single call. The
+// upstream client doesn't wrap this endpoint, so this issues the request
+// directly, through the same retrying transport used by every other call.
+func (c *RetryingClient) ListComponents(pageID string) ([]*is.ComponentFull, error) {
+	req, err := http.NewRequest(http.MethodGet, apiRoot+"/"+pageID+"/components", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.transport.cfg.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.transport.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed listing components, request returned %d", resp.StatusCode)
+	}
+
+	var components []*is.ComponentFull
+	if err := json.NewDecoder(resp.Body).Decode(&components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// retryingTransport implements is.HTTPClient, retrying requests that fail
+// with a rate-limit or transient server error using exponential backoff
+// with jitter, honoring any Retry-After header the API returns.
+type retryingTransport struct {
+	inner *http.Client
+	cfg   Config
+}
+
+func (t *retryingTransport) Do(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= int(t.cfg.MaxRetries); attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+
+		resp, err = t.inner.Do(req)
+		if err != nil || !shouldRetry(resp) || attempt == int(t.cfg.MaxRetries) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Debug(req.Context(), "retrying Instatus API request", map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait_ms": wait.Milliseconds(),
+		})
+		resp.Body.Close()
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return resp, req.Context().Err()
+		}
+	}
+
+	return resp, err
+}
+
+func shouldRetry(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns how long to wait before the next attempt, honoring a
+// Retry-After header when present, and otherwise using exponential backoff
+// with full jitter bounded by cfg.MaxRetryBackoff.
+func (t *retryingTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if seconds, err := strconv.Atoi(ra); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	max := float64(t.cfg.MaxRetryBackoff)
+	base := float64(t.cfg.MinRetryBackoff) * math.Pow(2, float64(attempt))
+	if base > max {
+		base = max
+	}
+	return time.Duration(rand.Float64() * base)
+}
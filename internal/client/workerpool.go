@@ -0,0 +1,32 @@
+package client
+
+import "sync"
+
+// RunBounded runs fn(i) for every i in [0, n) concurrently, bounded to at
+// most maxConcurrent calls of fn in flight at once (one goroutine is started
+// per index; all but maxConcurrent of them wait), and returns fn's errors
+// in index order (a nil entry means that call succeeded). This is the
+// fallback write path for resources that reconcile many upstream items in
+// a single operation (see instatus_components): paydaycay/instatus-client-go
+// has no endpoint to batch component writes into one request, so this is
+// the next best thing - a handful of requests in flight at once instead of
+// either one at a time or fully unbounded concurrency.
+func RunBounded(n, maxConcurrent int, fn func(i int) error) []error {
+	sem := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+
+	for i := 0; i < n; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
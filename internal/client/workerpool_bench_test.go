@@ -0,0 +1,46 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+// simulatedWriteLatency stands in for the network round-trip a real
+// CreateComponent/UpdateComponent/DeleteComponent call would incur.
+const simulatedWriteLatency = time.Millisecond
+
+// BenchmarkWrites_Sequential simulates writing every component on a
+// 50-component page one call at a time, the way a page with no batching
+// layer would: total wall-clock time scales with the page size.
+func BenchmarkWrites_Sequential(b *testing.B) {
+	const componentCount = 50
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < componentCount; n++ {
+			time.Sleep(simulatedWriteLatency)
+		}
+	}
+}
+
+// BenchmarkWrites_WorkerPool simulates the same 50 writes through
+// RunBounded: wall-clock time scales with componentCount/maxConcurrentWrites
+// instead of componentCount, since the Instatus API has no bulk-write
+// endpoint to coalesce them into a single request.
+func BenchmarkWrites_WorkerPool(b *testing.B) {
+	const componentCount = 50
+	const maxConcurrentWrites = 5
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		errs := RunBounded(componentCount, maxConcurrentWrites, func(int) error {
+			time.Sleep(simulatedWriteLatency)
+			return nil
+		})
+		for _, err := range errs {
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
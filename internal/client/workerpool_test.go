@@ -0,0 +1,56 @@
+package client
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunBounded_RespectsLimit(t *testing.T) {
+	const n = 20
+	const maxConcurrent = 3
+
+	var inFlight, maxObserved int32
+	errs := RunBounded(n, maxConcurrent, func(int) error {
+		cur := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxObserved)
+			if cur <= max || atomic.CompareAndSwapInt32(&maxObserved, max, cur) {
+				break
+			}
+		}
+		atomic.AddInt32(&inFlight, -1)
+		return nil
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("errs[%d] = %v, want nil", i, err)
+		}
+	}
+	if maxObserved > maxConcurrent {
+		t.Errorf("observed %d calls in flight at once, want at most %d", maxObserved, maxConcurrent)
+	}
+}
+
+func TestRunBounded_CollectsErrorsByIndex(t *testing.T) {
+	boom := errors.New("boom")
+	errs := RunBounded(5, 2, func(i int) error {
+		if i == 3 {
+			return boom
+		}
+		return nil
+	})
+
+	for i, err := range errs {
+		if i == 3 {
+			if err != boom {
+				t.Errorf("errs[3] = %v, want %v", err, boom)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("errs[%d] = %v, want nil", i, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Package diag classifies errors returned from paydaycay/instatus-client-go
+// and turns them into field-scoped Terraform diagnostics, so provider users
+// see actionable, attribute-level errors instead of a flat error string.
+package diag
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// statusCodePattern matches the HTTP status code embedded in the error
+// strings paydaycay/instatus-client-go formats for non-2xx responses, e.g.
+// "failed creating resource, request returned 503, full response: ..." or
+// "could not find component with ID: c1, http status 404". The library
+// doesn't expose a structured error type, so this is the only way to
+// recover the status code.
+var statusCodePattern = regexp.MustCompile(`(?:returned|status) (\d{3})`)
+
+// statusCode extracts the HTTP status code from err's message, if any.
+func statusCode(err error) (int, bool) {
+	match := statusCodePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0, false
+	}
+	code, parseErr := strconv.Atoi(match[1])
+	if parseErr != nil {
+		return 0, false
+	}
+	return code, true
+}
+
+// boilerplateMarkers are the substrings paydaycay/instatus-client-go's
+// generic createResource/readResource/updateResource/deleteResource helpers
+// append after their own wrapper text: a raw status code, and, for creates,
+// a "%+v" dump of the whole *http.Response (whose Status/StatusCode fields
+// mean the dump always contains the literal word "status"). None of that is
+// API-supplied validation text, so it must be stripped before field hints
+// are matched against it - otherwise "status" matches on virtually every
+// error, regardless of what the API actually complained about.
+var boilerplateMarkers = []string{
+	", full response:",
+	", request returned",
+	", http status",
+}
+
+// validationText returns the portion of msg that precedes the client
+// library's boilerplate, i.e. the only part that could ever carry
+// API-supplied detail about what was invalid.
+func validationText(msg string) string {
+	for _, marker := range boilerplateMarkers {
+		if i := strings.Index(msg, marker); i >= 0 {
+			return msg[:i]
+		}
+	}
+	return msg
+}
+
+// fieldHints maps substrings commonly found in Instatus API validation
+// messages to the schema attribute they describe, so errors can be attached
+// to the attribute the user actually needs to fix.
+var fieldHints = []struct {
+	substr string
+	attr   string
+}{
+	{"group", "group"},
+	{"status", "status"},
+	{"order", "order"},
+	{"name", "name"},
+}
+
+// attributeFor returns the schema attribute referenced by msg, or "" if none
+// of the known hints match. msg should already have had validationText
+// applied so boilerplate text can't produce a false match.
+func attributeFor(msg string) string {
+	lower := strings.ToLower(msg)
+	for _, hint := range fieldHints {
+		if strings.Contains(lower, hint.substr) {
+			return hint.attr
+		}
+	}
+	return ""
+}
+
+// HandleAPIError inspects err and appends the appropriate diagnostic to
+// diags, scoped to the field named in the message when one is recognized,
+// or reported under basePath otherwise. By the time an error reaches here,
+// RetryingClient has already exhausted its retries on anything retryable
+// (see shouldRetry in internal/client), so every classification below is
+// fatal: the operation did not succeed and must be reported as an error, not
+// a warning, or the framework will treat a failed Create/Update/Delete as
+// having succeeded with no state change recorded.
+func HandleAPIError(diags *diag.Diagnostics, basePath path.Path, summary string, err error) {
+	if err == nil {
+		return
+	}
+
+	code, ok := statusCode(err)
+	if !ok {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	attrPath := basePath
+	if attr := attributeFor(validationText(err.Error())); attr != "" {
+		attrPath = path.Root(attr)
+	}
+
+	switch {
+	case code == http.StatusTooManyRequests:
+		diags.AddAttributeError(attrPath, summary+": rate limited by Instatus", err.Error())
+	case code >= http.StatusInternalServerError:
+		diags.AddAttributeError(attrPath, summary+": Instatus returned a transient error", err.Error())
+	case code >= http.StatusBadRequest:
+		diags.AddAttributeError(attrPath, summary, err.Error())
+	default:
+		diags.AddError(summary, err.Error())
+	}
+}
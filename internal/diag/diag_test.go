@@ -0,0 +1,114 @@
+package diag
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// TestHandleAPIError_CreateErrorNotMisroutedToStatus reproduces the literal
+// error string createResourceCustomURL formats: a "%+v" dump of the raw
+// *http.Response, whose Status/StatusCode fields always contain the word
+// "status". Before validationText stripped the dump, this always matched
+// the "status" field hint, regardless of what the API actually rejected.
+func TestHandleAPIError_CreateErrorNotMisroutedToStatus(t *testing.T) {
+	resp := &http.Response{StatusCode: 400, Status: "400 Bad Request"}
+	err := fmt.Errorf("failed creating resource, request returned %d, full response: %+v", resp.StatusCode, resp)
+
+	var diags fwdiag.Diagnostics
+	HandleAPIError(&diags, path.Root("page_id"), "Error creating component", err)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+	for _, d := range diags {
+		ad, ok := d.(fwdiag.DiagnosticWithPath)
+		if !ok {
+			continue
+		}
+		if got := ad.Path().String(); got == "status" {
+			t.Errorf("diagnostic misrouted to path %q, want %q (the boilerplate response dump, not API validation text, matched the hint)", got, "page_id")
+		}
+	}
+}
+
+// TestHandleAPIError_ReadErrorNotMisroutedToStatus reproduces
+// readResourceCustomURL's default-branch error, which always contains the
+// literal substring "http status" and so always matched the "status" field
+// hint under the old, unstripped heuristic.
+func TestHandleAPIError_ReadErrorNotMisroutedToStatus(t *testing.T) {
+	err := fmt.Errorf("could not find %s, http status %d", "component with ID: c1", 404)
+
+	var diags fwdiag.Diagnostics
+	HandleAPIError(&diags, path.Root("id"), "Error reading Instatus component c1", err)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+	for _, d := range diags {
+		ad, ok := d.(fwdiag.DiagnosticWithPath)
+		if !ok {
+			continue
+		}
+		if got := ad.Path().String(); got == "status" {
+			t.Errorf("diagnostic misrouted to path %q, want %q", got, "id")
+		}
+	}
+}
+
+// TestHandleAPIError_FieldHintStillMatchesRealValidationText verifies that
+// a field hint appearing in the API's own validation text (i.e. before any
+// boilerplate marker) is still honored.
+func TestHandleAPIError_FieldHintStillMatchesRealValidationText(t *testing.T) {
+	err := fmt.Errorf("failed updating %s, request returned %d", "component with invalid group name", 400)
+
+	var diags fwdiag.Diagnostics
+	HandleAPIError(&diags, path.Root("id"), "Error updating Instatus component", err)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+	var sawGroupPath bool
+	for _, d := range diags {
+		if ad, ok := d.(fwdiag.DiagnosticWithPath); ok && ad.Path().String() == "group" {
+			sawGroupPath = true
+		}
+	}
+	if !sawGroupPath {
+		t.Errorf("expected a diagnostic scoped to path %q", "group")
+	}
+}
+
+// TestHandleAPIError_Severity verifies that 429s, 5xxs, and 4xxs are all
+// reported as errors (never warnings), and that an error with no
+// recognizable status code still produces a plain error.
+func TestHandleAPIError_Severity(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"rate limited", fmt.Errorf("failed updating %s, request returned %d", "component with ID: c1", http.StatusTooManyRequests)},
+		{"transient server error", fmt.Errorf("failed updating %s, request returned %d", "component with ID: c1", http.StatusInternalServerError)},
+		{"validation error", fmt.Errorf("failed updating %s, request returned %d", "component with ID: c1", http.StatusBadRequest)},
+		{"no status code", fmt.Errorf("network is unreachable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags fwdiag.Diagnostics
+			HandleAPIError(&diags, path.Root("id"), "Error updating Instatus component", tt.err)
+
+			if !diags.HasError() {
+				t.Fatalf("expected an error diagnostic for %q, got none (diagnostics: %v)", tt.err, diags)
+			}
+			for _, d := range diags {
+				if d.Severity() != fwdiag.SeverityError {
+					t.Errorf("diagnostic for %q has severity %v, want SeverityError", tt.err, d.Severity())
+				}
+			}
+		})
+	}
+}